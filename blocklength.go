@@ -0,0 +1,112 @@
+package main
+
+import "math"
+
+// AutoBlockLength picks a block length for MovingBlock/Stationary
+// bootstrap using the Politis-White rule: it searches for the smallest
+// lag m past which the sample autocorrelation stays inside the
+// significance band for the next K lags, then plugs the autocorrelations
+// up to 2m (capped at the largest lag searched, min(4K, N-1)) into a
+// bandwidth estimate. If the data is too short, if no such m is found
+// within the lags searched, or if the estimate is degenerate, it falls
+// back to the simple, well-tested ceil(N^(1/3)) rule.
+func AutoBlockLength(data []float64) int {
+	n := len(data)
+	fallback := int(math.Ceil(math.Cbrt(float64(n))))
+	if fallback < 1 {
+		fallback = 1
+	}
+	if n < 8 {
+		return fallback
+	}
+
+	kn := int(math.Ceil(2 * math.Sqrt(math.Log10(float64(n)))))
+	if kn < 1 {
+		return fallback
+	}
+	maxLag := 4 * kn
+	if maxLag >= n {
+		maxLag = n - 1
+	}
+	if maxLag < kn+1 {
+		return fallback
+	}
+
+	rho := make([]float64, maxLag+1)
+	for k := 0; k <= maxLag; k++ {
+		rho[k] = autocorrelation(data, k)
+	}
+	band := 2 * math.Sqrt(math.Log10(float64(n))/float64(n))
+
+	m := -1
+	for cand := 1; cand+kn <= maxLag; cand++ {
+		withinBand := true
+		for k := 1; k <= kn; k++ {
+			if math.Abs(rho[cand+k]) >= band {
+				withinBand = false
+				break
+			}
+		}
+		if withinBand {
+			m = cand
+			break
+		}
+	}
+	if m < 0 {
+		return fallback
+	}
+
+	lag := 2 * m
+	if lag > maxLag {
+		lag = maxLag
+	}
+	var gHat, gHatSq float64
+	for k := -lag; k <= lag; k++ {
+		weight := 1 - math.Abs(float64(k))/float64(2*m+1)
+		r := rho[absInt(k)]
+		gHat += weight * r
+		gHatSq += weight * r * r
+	}
+	if gHatSq <= 0 {
+		return fallback
+	}
+
+	blockLength := math.Cbrt((2 * gHat * gHat * float64(n)) / gHatSq)
+	if math.IsNaN(blockLength) || math.IsInf(blockLength, 0) || blockLength < 1 {
+		return fallback
+	}
+	l := int(math.Ceil(blockLength))
+	if l < 1 {
+		l = 1
+	}
+	if l > n {
+		l = n
+	}
+	return l
+}
+
+// autocorrelation returns the sample autocorrelation of data at the
+// given lag.
+func autocorrelation(data []float64, lag int) float64 {
+	n := len(data)
+	m := mean(data)
+	var num, den float64
+	for i := 0; i < n; i++ {
+		d := data[i] - m
+		den += d * d
+	}
+	if den == 0 {
+		return 0
+	}
+	for i := 0; i < n-lag; i++ {
+		num += (data[i] - m) * (data[i+lag] - m)
+	}
+	return num / den
+}
+
+func absInt(x int) int {
+	if x < 0 {
+		return -x
+	}
+	return x
+}
@@ -0,0 +1,20 @@
+package main
+
+import "testing"
+
+func TestAutoBlockLengthSmallSamples(t *testing.T) {
+	for _, n := range []int{0, 1, 4, 7} {
+		data := make([]float64, n)
+		if l := AutoBlockLength(data); l < 1 {
+			t.Errorf("AutoBlockLength(len=%d) = %d, want >= 1", n, l)
+		}
+	}
+}
+
+func TestAutoBlockLengthWithinPopulation(t *testing.T) {
+	population := generatePopulation(500, 100.0, 10.0)
+	l := AutoBlockLength(population)
+	if l < 1 || l > len(population) {
+		t.Errorf("AutoBlockLength returned %d, want in [1, %d]", l, len(population))
+	}
+}
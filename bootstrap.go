@@ -0,0 +1,153 @@
+package main
+
+import (
+	"encoding/binary"
+	"math/rand/v2"
+	"runtime"
+	"sort"
+	"sync"
+)
+
+// Options configures the concurrency and RNG seeding used by bootstrap
+// and centralLimitTheorem. The zero value is valid: Concurrency defaults
+// to runtime.NumCPU() and Seed defaults to a non-deterministic seed.
+type Options struct {
+	// Concurrency is the number of worker goroutines to use. Zero or
+	// negative means runtime.NumCPU().
+	Concurrency int
+	// Seed seeds the ChaCha8 source that per-replicate RNGs are derived
+	// from. A zero Seed means "pick a fresh, non-deterministic seed".
+	Seed [32]byte
+	// Scheme selects how each replicate is resampled from the
+	// population. The zero value, IID, is the original behavior.
+	Scheme Scheme
+	// BlockLength is the block length used by MovingBlock and Stationary
+	// (the mean block length, for Stationary). Zero or negative means
+	// AutoBlockLength(population).
+	BlockLength int
+	// Strata assigns each population element to a stratum, for
+	// Stratified. It must have the same length as the population, and
+	// the sample size passed to bootstrap/centralLimitTheorem must equal
+	// len(Strata) so every stratum's size is preserved.
+	Strata []int
+}
+
+// resolved returns a copy of o with defaults filled in: Concurrency
+// defaults to runtime.NumCPU(), and an unset Seed is replaced with a
+// fresh, non-deterministic one.
+func (o Options) resolved() Options {
+	if o.Concurrency <= 0 {
+		o.Concurrency = runtime.NumCPU()
+	}
+	if o.Seed == [32]byte{} {
+		var fresh [32]byte
+		binary.LittleEndian.PutUint64(fresh[0:], rand.Uint64())
+		binary.LittleEndian.PutUint64(fresh[8:], rand.Uint64())
+		binary.LittleEndian.PutUint64(fresh[16:], rand.Uint64())
+		binary.LittleEndian.PutUint64(fresh[24:], rand.Uint64())
+		o.Seed = fresh
+	}
+	return o
+}
+
+// newSeededRand builds a *rand.Rand from a ChaCha8 source seeded with
+// seed, the standard way every seeded RNG in this package is built.
+func newSeededRand(seed [32]byte) *rand.Rand {
+	return rand.New(rand.NewChaCha8(seed))
+}
+
+// deriveSeeds expands a single ChaCha8 seed into n independent sub-seeds,
+// one per replicate. Generating them up front from one sequential source
+// means the replicate a sub-seed belongs to never depends on how the
+// replicates are split across workers, so results stay identical no
+// matter how Concurrency is set.
+func deriveSeeds(seed [32]byte, n int) [][32]byte {
+	rng := newSeededRand(seed)
+	seeds := make([][32]byte, n)
+	for i := range seeds {
+		binary.LittleEndian.PutUint64(seeds[i][0:], rng.Uint64())
+		binary.LittleEndian.PutUint64(seeds[i][8:], rng.Uint64())
+		binary.LittleEndian.PutUint64(seeds[i][16:], rng.Uint64())
+		binary.LittleEndian.PutUint64(seeds[i][24:], rng.Uint64())
+	}
+	return seeds
+}
+
+// runPool fans the n replicates indexed [0,n) out across concurrency
+// worker goroutines, calling work for each index with a scratch buffer
+// the worker reuses across its replicates and a *rand.Rand seeded from
+// seeds[i]. It blocks until every replicate has been processed.
+func runPool(n, concurrency, scratchSize int, seeds [][32]byte, work func(i int, rng *rand.Rand, scratch []float64)) {
+	if concurrency > n {
+		concurrency = n
+	}
+	indices := make(chan int)
+	var wg sync.WaitGroup
+	wg.Add(concurrency)
+	for w := 0; w < concurrency; w++ {
+		go func() {
+			defer wg.Done()
+			scratch := make([]float64, scratchSize)
+			for i := range indices {
+				rng := newSeededRand(seeds[i])
+				work(i, rng, scratch)
+			}
+		}()
+	}
+	for i := 0; i < n; i++ {
+		indices <- i
+	}
+	close(indices)
+	wg.Wait()
+}
+
+// bootstrap performs bootstrap resampling and returns the full vectors of
+// replicate means and medians, so callers that need more than the
+// standard errors (e.g. confidence intervals, Report summaries) don't
+// have to re-run the resampling.
+func bootstrap(population []float64, bootstrapSamples, sampleSize int, opts ...Options) (means, medians []float64) {
+	var o Options
+	if len(opts) > 0 {
+		o = opts[0]
+	}
+	resolved := o.resolved()
+	validateScheme(population, sampleSize, resolved)
+	seeds := deriveSeeds(resolved.Seed, bootstrapSamples)
+	sample := newSampler(population, resolved)
+
+	means = make([]float64, bootstrapSamples)
+	medians = make([]float64, bootstrapSamples)
+	runPool(bootstrapSamples, resolved.Concurrency, sampleSize, seeds, func(i int, rng *rand.Rand, scratch []float64) {
+		sample(scratch, rng)
+		means[i] = mean(scratch)
+		sort.Float64s(scratch)
+		medians[i] = medianOfSorted(scratch)
+	})
+	return means, medians
+}
+
+// BootstrapSE runs bootstrap and reduces its replicates to the standard
+// errors of the mean and median, for callers that only need the summary.
+func BootstrapSE(population []float64, bootstrapSamples, sampleSize int, opts ...Options) (seMean, seMedian float64) {
+	means, medians := bootstrap(population, bootstrapSamples, sampleSize, opts...)
+	return standardError(means), standardError(medians)
+}
+
+// Perform Central Limit Theorem
+func centralLimitTheorem(population []float64, sampleSize, numSamples int, opts ...Options) float64 {
+	var o Options
+	if len(opts) > 0 {
+		o = opts[0]
+	}
+	resolved := o.resolved()
+	validateScheme(population, sampleSize, resolved)
+	seeds := deriveSeeds(resolved.Seed, numSamples)
+	sample := newSampler(population, resolved)
+
+	sampleMeans := make([]float64, numSamples)
+	runPool(numSamples, resolved.Concurrency, sampleSize, seeds, func(i int, rng *rand.Rand, scratch []float64) {
+		sample(scratch, rng)
+		sampleMeans[i] = mean(scratch)
+	})
+	return standardError(sampleMeans)
+}
@@ -0,0 +1,40 @@
+package main
+
+import "testing"
+
+func TestBootstrap(t *testing.T) {
+	population := generatePopulation(100, 100.0, 10.0)
+	means, medians := bootstrap(population, 10, 10)
+	if len(means) != 10 || len(medians) != 10 {
+		t.Fatalf("expected 10 replicates each, got %d means and %d medians", len(means), len(medians))
+	}
+	seMean, seMedian := BootstrapSE(population, 10, 10)
+	if seMean <= 0 {
+		t.Errorf("Expected positive SE Mean, got %f", seMean)
+	}
+	if seMedian <= 0 {
+		t.Errorf("Expected positive SE Median, got %f", seMedian)
+	}
+}
+
+func TestBootstrapSeedReproducible(t *testing.T) {
+	population := generatePopulation(500, 100.0, 10.0)
+	seed := [32]byte{1, 2, 3}
+
+	seMean1, seMedian1 := BootstrapSE(population, 200, 50, Options{Seed: seed})
+	seMean2, seMedian2 := BootstrapSE(population, 200, 50, Options{Seed: seed})
+	if seMean1 != seMean2 || seMedian1 != seMedian2 {
+		t.Fatalf("same seed produced different results: (%f, %f) vs (%f, %f)", seMean1, seMedian1, seMean2, seMedian2)
+	}
+}
+
+func TestBootstrapSeedIndependentOfConcurrency(t *testing.T) {
+	population := generatePopulation(500, 100.0, 10.0)
+	seed := [32]byte{7, 7, 7}
+
+	seMean1, seMedian1 := BootstrapSE(population, 200, 50, Options{Seed: seed, Concurrency: 1})
+	seMean2, seMedian2 := BootstrapSE(population, 200, 50, Options{Seed: seed, Concurrency: 8})
+	if seMean1 != seMean2 || seMedian1 != seMedian2 {
+		t.Fatalf("identical seed gave different results across concurrency levels: (%f, %f) vs (%f, %f)", seMean1, seMedian1, seMean2, seMedian2)
+	}
+}
@@ -0,0 +1,139 @@
+package main
+
+import (
+	"math"
+	"math/rand/v2"
+	"sort"
+)
+
+// CIMethod selects the construction used by BootstrapCI.
+type CIMethod int
+
+const (
+	// Percentile takes the alpha/2 and 1-alpha/2 quantiles of the
+	// bootstrap replicates directly.
+	Percentile CIMethod = iota
+	// Basic (pivotal) reflects the percentile interval around the
+	// observed statistic: 2*theta_hat - q.
+	Basic
+	// BCa is the bias-corrected and accelerated interval, adjusting the
+	// quantiles used for skew and for bias in the bootstrap distribution.
+	BCa
+)
+
+func (m CIMethod) String() string {
+	switch m {
+	case Basic:
+		return "basic"
+	case BCa:
+		return "bca"
+	default:
+		return "percentile"
+	}
+}
+
+// BootstrapCI returns a two-sided (1-alpha) confidence interval for stat
+// applied to population, built from B bootstrap replicates of size n.
+// point is stat evaluated on population itself.
+func BootstrapCI(population []float64, stat func([]float64) float64, B, n int, alpha float64, method CIMethod, opts ...Options) (lo, hi, point float64) {
+	point = stat(population)
+	replicates := statReplicates(population, stat, B, n, opts...)
+	sorted := append([]float64(nil), replicates...)
+	sort.Float64s(sorted)
+
+	switch method {
+	case Basic:
+		qLo := percentile(sorted, 100*alpha/2)
+		qHi := percentile(sorted, 100*(1-alpha/2))
+		lo, hi = 2*point-qHi, 2*point-qLo
+	case BCa:
+		lo, hi = bcaInterval(population, stat, replicates, sorted, point, alpha)
+	default:
+		lo = percentile(sorted, 100*alpha/2)
+		hi = percentile(sorted, 100*(1-alpha/2))
+	}
+	return lo, hi, point
+}
+
+// statReplicates draws B bootstrap samples of size n from population and
+// evaluates stat on each, reusing the same seeded worker pool as
+// bootstrap and centralLimitTheorem.
+func statReplicates(population []float64, stat func([]float64) float64, B, n int, opts ...Options) []float64 {
+	var o Options
+	if len(opts) > 0 {
+		o = opts[0]
+	}
+	resolved := o.resolved()
+	validateScheme(population, n, resolved)
+	seeds := deriveSeeds(resolved.Seed, B)
+	sample := newSampler(population, resolved)
+
+	replicates := make([]float64, B)
+	runPool(B, resolved.Concurrency, n, seeds, func(i int, rng *rand.Rand, scratch []float64) {
+		sample(scratch, rng)
+		replicates[i] = stat(scratch)
+	})
+	return replicates
+}
+
+// jackknifeReplicates computes stat on each leave-one-out subset of data.
+func jackknifeReplicates(data []float64, stat func([]float64) float64) []float64 {
+	n := len(data)
+	replicates := make([]float64, n)
+	loo := make([]float64, n-1)
+	for i := 0; i < n; i++ {
+		copy(loo, data[:i])
+		copy(loo[i:], data[i+1:])
+		replicates[i] = stat(loo)
+	}
+	return replicates
+}
+
+// bcaInterval applies the bias-correction z0 and acceleration a to map
+// alpha/2 and 1-alpha/2 through the adjusted normal quantiles, then reads
+// off the corresponding empirical quantiles of the bootstrap replicates.
+func bcaInterval(population []float64, stat func([]float64) float64, replicates, sorted []float64, point, alpha float64) (lo, hi float64) {
+	countLess := 0
+	for _, r := range replicates {
+		if r < point {
+			countLess++
+		}
+	}
+	// Clamp away from 0 and len(replicates): at those extremes the
+	// bias-correction proportion is 0 or 1, normalQuantile returns +-Inf,
+	// and that Inf propagates through a1/a2 into NaN. This is reachable on
+	// ordinary input (small B, a skewed population, or an extreme-order
+	// stat like min/max), not just pathological ones.
+	proportion := (float64(countLess) + 0.5) / (float64(len(replicates)) + 1)
+	z0 := normalQuantile(proportion)
+
+	jack := jackknifeReplicates(population, stat)
+	jackMean := mean(jack)
+	var num, den float64
+	for _, v := range jack {
+		d := jackMean - v
+		num += d * d * d
+		den += d * d
+	}
+	a := num / (6 * math.Pow(den, 1.5))
+
+	zLo := normalQuantile(alpha / 2)
+	zHi := normalQuantile(1 - alpha/2)
+
+	a1 := normalCDF(z0 + (z0+zLo)/(1-a*(z0+zLo)))
+	a2 := normalCDF(z0 + (z0+zHi)/(1-a*(z0+zHi)))
+
+	lo = percentile(sorted, 100*a1)
+	hi = percentile(sorted, 100*a2)
+	return lo, hi
+}
+
+// normalCDF is the standard normal CDF, Phi(x).
+func normalCDF(x float64) float64 {
+	return 0.5 * (1 + math.Erf(x/math.Sqrt2))
+}
+
+// normalQuantile is the standard normal quantile function, Phi^-1(p).
+func normalQuantile(p float64) float64 {
+	return math.Sqrt2 * math.Erfinv(2*p-1)
+}
@@ -0,0 +1,57 @@
+package main
+
+import (
+	"math"
+	"testing"
+)
+
+func TestBootstrapCIPercentileMatchesAnalyticalNormal(t *testing.T) {
+	const (
+		size   = 5000
+		mu     = 100.0
+		sigma  = 10.0
+		alpha  = 0.05
+		B      = 2000
+		sample = 5000
+	)
+	population := generatePopulation(size, mu, sigma)
+
+	lo, hi, point := BootstrapCI(population, Mean, B, sample, alpha, Percentile, Options{Seed: [32]byte{9}})
+
+	se := sigma / math.Sqrt(float64(sample))
+	wantLo := mu - 1.96*se
+	wantHi := mu + 1.96*se
+
+	const tol = 0.5
+	if math.Abs(lo-wantLo) > tol || math.Abs(hi-wantHi) > tol {
+		t.Errorf("percentile CI [%.3f, %.3f] (point %.3f) too far from analytical [%.3f, %.3f]", lo, hi, point, wantLo, wantHi)
+	}
+}
+
+func TestBootstrapCIMethodsBracketPoint(t *testing.T) {
+	population := generatePopulation(1000, 50.0, 5.0)
+	for _, method := range []CIMethod{Percentile, Basic, BCa} {
+		lo, hi, point := BootstrapCI(population, Mean, 500, 200, 0.05, method, Options{Seed: [32]byte{3}})
+		if lo > point || hi < point {
+			t.Errorf("%s: CI [%.3f, %.3f] does not bracket point estimate %.3f", method, lo, hi, point)
+		}
+	}
+}
+
+func minStat(data []float64) float64 {
+	m := data[0]
+	for _, v := range data[1:] {
+		if v < m {
+			m = v
+		}
+	}
+	return m
+}
+
+func TestBootstrapCIBCaHandlesExtremeStatWithoutPanicking(t *testing.T) {
+	population := generatePopulation(30, 50.0, 5.0)
+	lo, hi, point := BootstrapCI(population, minStat, 20, 10, 0.05, BCa, Options{Seed: [32]byte{7}})
+	if math.IsNaN(lo) || math.IsNaN(hi) || math.IsInf(lo, 0) || math.IsInf(hi, 0) {
+		t.Fatalf("BCa CI [%v, %v] (point %v) is not finite", lo, hi, point)
+	}
+}
@@ -0,0 +1,149 @@
+package main
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"math"
+	"os"
+	"strconv"
+	"strings"
+)
+
+// PopulationSource generates or loads a population of float64 values.
+// Synthetic sources draw exactly size samples; the empirical sources
+// (FromCSV, FromReader) ignore size and return every value they parse.
+type PopulationSource interface {
+	Generate(size int) ([]float64, error)
+}
+
+// NormalSource draws samples from a Normal(Mean, StdDev) distribution.
+type NormalSource struct {
+	Mean, StdDev float64
+	Seed         [32]byte
+}
+
+func (s NormalSource) Generate(size int) ([]float64, error) {
+	rng := newSeededRand(s.Seed)
+	out := make([]float64, size)
+	for i := range out {
+		out[i] = rng.NormFloat64()*s.StdDev + s.Mean
+	}
+	return out, nil
+}
+
+// LogNormalSource draws samples whose logarithm is Normal(Mu, Sigma).
+type LogNormalSource struct {
+	Mu, Sigma float64
+	Seed      [32]byte
+}
+
+func (s LogNormalSource) Generate(size int) ([]float64, error) {
+	rng := newSeededRand(s.Seed)
+	out := make([]float64, size)
+	for i := range out {
+		out[i] = math.Exp(rng.NormFloat64()*s.Sigma + s.Mu)
+	}
+	return out, nil
+}
+
+// ExponentialSource draws samples from an Exponential(Rate) distribution
+// using math/rand/v2's ziggurat-based ExpFloat64.
+type ExponentialSource struct {
+	Rate float64
+	Seed [32]byte
+}
+
+func (s ExponentialSource) Generate(size int) ([]float64, error) {
+	rng := newSeededRand(s.Seed)
+	out := make([]float64, size)
+	for i := range out {
+		out[i] = rng.ExpFloat64() / s.Rate
+	}
+	return out, nil
+}
+
+// UniformSource draws samples uniformly from [Min, Max).
+type UniformSource struct {
+	Min, Max float64
+	Seed     [32]byte
+}
+
+func (s UniformSource) Generate(size int) ([]float64, error) {
+	rng := newSeededRand(s.Seed)
+	out := make([]float64, size)
+	for i := range out {
+		out[i] = s.Min + rng.Float64()*(s.Max-s.Min)
+	}
+	return out, nil
+}
+
+// FromReader builds a PopulationSource that reads one float64 per
+// non-empty line from r.
+func FromReader(r io.Reader) PopulationSource { return readerSource{r} }
+
+type readerSource struct{ r io.Reader }
+
+func (s readerSource) Generate(int) ([]float64, error) {
+	var out []float64
+	scanner := bufio.NewScanner(s.r)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" {
+			continue
+		}
+		v, err := strconv.ParseFloat(line, 64)
+		if err != nil {
+			return nil, fmt.Errorf("parsing population value %q: %w", line, err)
+		}
+		out = append(out, v)
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// FromCSV builds a PopulationSource that reads one float64 per non-empty
+// line from the file at path.
+func FromCSV(path string) PopulationSource { return fileSource{path} }
+
+type fileSource struct{ path string }
+
+func (s fileSource) Generate(size int) ([]float64, error) {
+	f, err := os.Open(s.path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	return readerSource{f}.Generate(size)
+}
+
+// parseDistSource resolves the --dist flag value to a PopulationSource,
+// reusing mean and stddev as the natural parameters of each synthetic
+// distribution.
+func parseDistSource(spec string, mean, stddev float64) (PopulationSource, error) {
+	if path, ok := strings.CutPrefix(spec, "csv:"); ok {
+		return FromCSV(path), nil
+	}
+	switch spec {
+	case "normal", "":
+		return NormalSource{Mean: mean, StdDev: stddev}, nil
+	case "lognormal":
+		return LogNormalSource{Mu: mean, Sigma: stddev}, nil
+	case "exp":
+		return ExponentialSource{Rate: 1 / mean}, nil
+	case "uniform":
+		half := stddev * math.Sqrt(3)
+		return UniformSource{Min: mean - half, Max: mean + half}, nil
+	default:
+		return nil, fmt.Errorf("unknown population distribution %q (want normal, lognormal, exp, uniform, or csv:path)", spec)
+	}
+}
+
+// generatePopulation is a convenience wrapper around NormalSource for
+// callers that only need a quick, reproducible Normal population.
+func generatePopulation(size int, mean, stddev float64) []float64 {
+	population, _ := NormalSource{Mean: mean, StdDev: stddev, Seed: [32]byte{42}}.Generate(size)
+	return population
+}
@@ -0,0 +1,76 @@
+package main
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestNormalSourceSize(t *testing.T) {
+	population, err := NormalSource{Mean: 100, StdDev: 10, Seed: [32]byte{1}}.Generate(50)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(population) != 50 {
+		t.Errorf("expected 50 samples, got %d", len(population))
+	}
+}
+
+func TestExponentialSourcePositive(t *testing.T) {
+	population, err := ExponentialSource{Rate: 2, Seed: [32]byte{2}}.Generate(100)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	for _, v := range population {
+		if v <= 0 {
+			t.Fatalf("exponential sample should be positive, got %f", v)
+		}
+	}
+}
+
+func TestUniformSourceBounds(t *testing.T) {
+	population, err := UniformSource{Min: 1, Max: 2, Seed: [32]byte{3}}.Generate(200)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	for _, v := range population {
+		if v < 1 || v >= 2 {
+			t.Fatalf("uniform sample out of bounds: %f", v)
+		}
+	}
+}
+
+func TestFromReader(t *testing.T) {
+	r := strings.NewReader("1.5\n2.5\n\n3.5\n")
+	population, err := FromReader(r).Generate(0)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	want := []float64{1.5, 2.5, 3.5}
+	if len(population) != len(want) {
+		t.Fatalf("expected %d values, got %d", len(want), len(population))
+	}
+	for i := range want {
+		if population[i] != want[i] {
+			t.Errorf("index %d: got %f, want %f", i, population[i], want[i])
+		}
+	}
+}
+
+func TestFromReaderInvalidLine(t *testing.T) {
+	r := strings.NewReader("1.5\nnot-a-number\n")
+	if _, err := FromReader(r).Generate(0); err == nil {
+		t.Error("expected an error for an unparseable line")
+	}
+}
+
+func TestParseDistSource(t *testing.T) {
+	cases := []string{"normal", "lognormal", "exp", "uniform", "csv:/tmp/does-not-matter.csv"}
+	for _, spec := range cases {
+		if _, err := parseDistSource(spec, 100, 10); err != nil {
+			t.Errorf("parseDistSource(%q) failed: %v", spec, err)
+		}
+	}
+	if _, err := parseDistSource("bogus", 100, 10); err == nil {
+		t.Error("expected an error for an unknown distribution")
+	}
+}
@@ -0,0 +1,146 @@
+package main
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"sort"
+	"strconv"
+)
+
+// Summary holds descriptive statistics for one vector of bootstrap
+// replicates.
+type Summary struct {
+	Min, Max, Mean, Median, StdDev float64
+	P25, P50, P75, P95, P99        float64
+}
+
+// summarize computes a Summary over data.
+func summarize(data []float64) Summary {
+	sorted := append([]float64(nil), data...)
+	sort.Float64s(sorted)
+	return Summary{
+		Min:    sorted[0],
+		Max:    sorted[len(sorted)-1],
+		Mean:   mean(data),
+		Median: medianOfSorted(sorted),
+		StdDev: stddev(data),
+		P25:    percentile(sorted, 25),
+		P50:    percentile(sorted, 50),
+		P75:    percentile(sorted, 75),
+		P95:    percentile(sorted, 95),
+		P99:    percentile(sorted, 99),
+	}
+}
+
+// SampleSizeReport captures the CLT and bootstrap results for one sample
+// size, including full replicate summaries so a Reporter can render as
+// much or as little detail as it needs.
+type SampleSizeReport struct {
+	SampleSize   int     `json:"sample_size"`
+	CLTSEMean    float64 `json:"clt_se_mean"`
+	BootSEMean   float64 `json:"boot_se_mean"`
+	BootSEMedian float64 `json:"boot_se_median"`
+	Means        Summary `json:"means"`
+	Medians      Summary `json:"medians"`
+}
+
+// NewSampleSizeReport runs the CLT and bootstrap for sampleSize and
+// summarizes the resulting replicate vectors.
+func NewSampleSizeReport(population []float64, sampleSize, bootstrapSamples, numSamples int, opts ...Options) SampleSizeReport {
+	cltSEMean := centralLimitTheorem(population, sampleSize, numSamples, opts...)
+	means, medians := bootstrap(population, bootstrapSamples, sampleSize, opts...)
+	return SampleSizeReport{
+		SampleSize:   sampleSize,
+		CLTSEMean:    cltSEMean,
+		BootSEMean:   standardError(means),
+		BootSEMedian: standardError(medians),
+		Means:        summarize(means),
+		Medians:      summarize(medians),
+	}
+}
+
+// Reporter renders a completed set of SampleSizeReports to w.
+type Reporter interface {
+	Report(w io.Writer, reports []SampleSizeReport) error
+}
+
+// TextReporter renders reports as human-readable log lines, matching the
+// original fmt.Printf output plus the new summary statistics.
+type TextReporter struct{}
+
+func (TextReporter) Report(w io.Writer, reports []SampleSizeReport) error {
+	for _, r := range reports {
+		fmt.Fprintf(w, "Samples of size n = %d\n", r.SampleSize)
+		fmt.Fprintf(w, "  SE Mean from Central Limit Theorem for n = %d: %.2f\n", r.SampleSize, r.CLTSEMean)
+		fmt.Fprintf(w, "  SE Mean from Bootstrap Samples: %.2f\n", r.BootSEMean)
+		fmt.Fprintf(w, "  SE Median from Bootstrap Samples: %.2f\n", r.BootSEMedian)
+		fmt.Fprintf(w, "  Bootstrap means:   min=%.2f max=%.2f mean=%.2f median=%.2f stddev=%.2f p25=%.2f p75=%.2f p95=%.2f p99=%.2f\n",
+			r.Means.Min, r.Means.Max, r.Means.Mean, r.Means.Median, r.Means.StdDev, r.Means.P25, r.Means.P75, r.Means.P95, r.Means.P99)
+		fmt.Fprintf(w, "  Bootstrap medians: min=%.2f max=%.2f mean=%.2f median=%.2f stddev=%.2f p25=%.2f p75=%.2f p95=%.2f p99=%.2f\n",
+			r.Medians.Min, r.Medians.Max, r.Medians.Mean, r.Medians.Median, r.Medians.StdDev, r.Medians.P25, r.Medians.P75, r.Medians.P95, r.Medians.P99)
+	}
+	return nil
+}
+
+// JSONReporter writes one JSON record per sample size (JSON Lines).
+type JSONReporter struct{}
+
+func (JSONReporter) Report(w io.Writer, reports []SampleSizeReport) error {
+	enc := json.NewEncoder(w)
+	for _, r := range reports {
+		if err := enc.Encode(r); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// CSVReporter writes one row per sample size, suitable for downstream
+// plotting.
+type CSVReporter struct{}
+
+func (CSVReporter) Report(w io.Writer, reports []SampleSizeReport) error {
+	cw := csv.NewWriter(w)
+	header := []string{
+		"sample_size", "clt_se_mean", "boot_se_mean", "boot_se_median",
+		"means_min", "means_max", "means_mean", "means_median", "means_stddev", "means_p25", "means_p50", "means_p75", "means_p95", "means_p99",
+		"medians_min", "medians_max", "medians_mean", "medians_median", "medians_stddev", "medians_p25", "medians_p50", "medians_p75", "medians_p95", "medians_p99",
+	}
+	if err := cw.Write(header); err != nil {
+		return err
+	}
+	f := strconv.FormatFloat
+	for _, r := range reports {
+		row := []string{
+			strconv.Itoa(r.SampleSize),
+			f(r.CLTSEMean, 'f', -1, 64),
+			f(r.BootSEMean, 'f', -1, 64),
+			f(r.BootSEMedian, 'f', -1, 64),
+			f(r.Means.Min, 'f', -1, 64), f(r.Means.Max, 'f', -1, 64), f(r.Means.Mean, 'f', -1, 64), f(r.Means.Median, 'f', -1, 64), f(r.Means.StdDev, 'f', -1, 64),
+			f(r.Means.P25, 'f', -1, 64), f(r.Means.P50, 'f', -1, 64), f(r.Means.P75, 'f', -1, 64), f(r.Means.P95, 'f', -1, 64), f(r.Means.P99, 'f', -1, 64),
+			f(r.Medians.Min, 'f', -1, 64), f(r.Medians.Max, 'f', -1, 64), f(r.Medians.Mean, 'f', -1, 64), f(r.Medians.Median, 'f', -1, 64), f(r.Medians.StdDev, 'f', -1, 64),
+			f(r.Medians.P25, 'f', -1, 64), f(r.Medians.P50, 'f', -1, 64), f(r.Medians.P75, 'f', -1, 64), f(r.Medians.P95, 'f', -1, 64), f(r.Medians.P99, 'f', -1, 64),
+		}
+		if err := cw.Write(row); err != nil {
+			return err
+		}
+	}
+	cw.Flush()
+	return cw.Error()
+}
+
+// reporterFor resolves the --report flag value to a Reporter.
+func reporterFor(name string) (Reporter, error) {
+	switch name {
+	case "text", "":
+		return TextReporter{}, nil
+	case "json":
+		return JSONReporter{}, nil
+	case "csv":
+		return CSVReporter{}, nil
+	default:
+		return nil, fmt.Errorf("unknown report format %q (want text, json, or csv)", name)
+	}
+}
@@ -0,0 +1,56 @@
+package main
+
+import (
+	"bytes"
+	"math"
+	"testing"
+)
+
+func TestPercentileKnownInputs(t *testing.T) {
+	sorted := []float64{1, 2, 3, 4, 5, 6, 7, 8, 9, 10}
+	cases := []struct {
+		p    float64
+		want float64
+	}{
+		{0, 1},
+		{50, 5.5},
+		{100, 10},
+		{25, 3.25},
+		{75, 7.75},
+	}
+	for _, c := range cases {
+		got := percentile(sorted, c.p)
+		if math.Abs(got-c.want) > 1e-9 {
+			t.Errorf("percentile(%v, %v) = %v, want %v", sorted, c.p, got, c.want)
+		}
+	}
+}
+
+func TestSummarize(t *testing.T) {
+	data := []float64{1, 2, 3, 4, 5}
+	s := summarize(data)
+	if s.Min != 1 || s.Max != 5 || s.Mean != 3 || s.Median != 3 {
+		t.Errorf("unexpected summary: %+v", s)
+	}
+}
+
+func TestReportersProduceOutput(t *testing.T) {
+	reports := []SampleSizeReport{
+		NewSampleSizeReport(generatePopulation(200, 100.0, 10.0), 25, 20, 20, Options{Seed: [32]byte{1}}),
+	}
+	for name, r := range map[string]Reporter{"text": TextReporter{}, "json": JSONReporter{}, "csv": CSVReporter{}} {
+		var buf bytes.Buffer
+		if err := r.Report(&buf, reports); err != nil {
+			t.Errorf("%s reporter failed: %v", name, err)
+		}
+		if buf.Len() == 0 {
+			t.Errorf("%s reporter produced no output", name)
+		}
+	}
+}
+
+func TestReporterForUnknownFormat(t *testing.T) {
+	if _, err := reporterFor("yaml"); err == nil {
+		t.Error("expected an error for an unknown report format")
+	}
+}
@@ -0,0 +1,174 @@
+package main
+
+import (
+	"fmt"
+	"math"
+	"math/rand/v2"
+	"sort"
+)
+
+// Scheme selects how a replicate's elements are drawn from the
+// population.
+type Scheme int
+
+const (
+	// IID draws each element independently, with replacement, from the
+	// whole population. This is the original behavior and is invalid for
+	// dependent (e.g. time series) or clustered data.
+	IID Scheme = iota
+	// MovingBlock draws fixed-length overlapping blocks of contiguous
+	// population indices with replacement, preserving local dependence.
+	MovingBlock
+	// Stationary draws blocks whose length is Geometric(1/BlockLength)
+	// per block, which keeps the resampled series stationary.
+	Stationary
+	// Stratified resamples with replacement within each stratum of
+	// Options.Strata, preserving stratum sizes.
+	Stratified
+)
+
+// validateScheme panics with a clear message if o.Scheme is Stratified
+// and its preconditions don't hold. runPool allocates each worker's
+// scratch buffer once and reuses it across every replicate that worker
+// computes, so fillStratified must always fill it completely: a
+// mismatched sampleSize would otherwise either leave the tail of scratch
+// holding values from a previous, unrelated replicate (sampleSize too
+// large) or panic with an unhelpful index-out-of-range (sampleSize too
+// small).
+func validateScheme(population []float64, sampleSize int, o Options) {
+	if o.Scheme != Stratified {
+		return
+	}
+	if len(o.Strata) != len(population) {
+		panic(fmt.Sprintf("bootstrap: Stratified requires len(Options.Strata) == len(population), got %d and %d", len(o.Strata), len(population)))
+	}
+	if sampleSize != len(o.Strata) {
+		panic(fmt.Sprintf("bootstrap: Stratified requires sampleSize == len(Options.Strata), got sampleSize=%d, len(Strata)=%d", sampleSize, len(o.Strata)))
+	}
+}
+
+// newSampler builds the function that fills a replicate's scratch buffer
+// from population according to o.Scheme. Anything that can be shared
+// across every replicate of a bootstrap run (the block length, the
+// stratum index groups) is computed once here rather than per draw.
+func newSampler(population []float64, o Options) func(scratch []float64, rng *rand.Rand) {
+	switch o.Scheme {
+	case MovingBlock:
+		blockLength := o.BlockLength
+		if blockLength <= 0 {
+			blockLength = AutoBlockLength(population)
+		}
+		return func(scratch []float64, rng *rand.Rand) {
+			fillMovingBlock(scratch, population, rng, blockLength)
+		}
+	case Stationary:
+		blockLength := o.BlockLength
+		if blockLength <= 0 {
+			blockLength = AutoBlockLength(population)
+		}
+		return func(scratch []float64, rng *rand.Rand) {
+			fillStationary(scratch, population, rng, blockLength)
+		}
+	case Stratified:
+		groups := groupByStratum(o.Strata)
+		return func(scratch []float64, rng *rand.Rand) {
+			fillStratified(scratch, population, rng, groups)
+		}
+	default:
+		return func(scratch []float64, rng *rand.Rand) {
+			for j := range scratch {
+				scratch[j] = population[rng.IntN(len(population))]
+			}
+		}
+	}
+}
+
+// fillMovingBlock draws ceil(len(scratch)/blockLength) overlapping blocks
+// of blockLength contiguous population indices with replacement,
+// concatenating them and truncating to len(scratch).
+func fillMovingBlock(scratch, population []float64, rng *rand.Rand, blockLength int) {
+	n := len(population)
+	if blockLength > n {
+		blockLength = n
+	}
+	numBlocks := n - blockLength + 1
+
+	pos := 0
+	for pos < len(scratch) {
+		start := rng.IntN(numBlocks)
+		for j := 0; j < blockLength && pos < len(scratch); j++ {
+			scratch[pos] = population[start+j]
+			pos++
+		}
+	}
+}
+
+// fillStationary draws blocks of contiguous (circularly wrapped)
+// population indices whose length is Geometric(1/meanBlockLength) per
+// block, concatenating them and truncating to len(scratch).
+func fillStationary(scratch, population []float64, rng *rand.Rand, meanBlockLength int) {
+	n := len(population)
+	p := 1.0 / float64(meanBlockLength)
+
+	pos := 0
+	for pos < len(scratch) {
+		start := rng.IntN(n)
+		length := geometricLength(rng, p)
+		for j := 0; j < length && pos < len(scratch); j++ {
+			scratch[pos] = population[(start+j)%n]
+			pos++
+		}
+	}
+}
+
+// geometricLength draws a Geometric(p) block length (minimum 1) via
+// inverse-CDF sampling.
+func geometricLength(rng *rand.Rand, p float64) int {
+	if p >= 1 {
+		return 1
+	}
+	u := rng.Float64()
+	length := int(math.Ceil(math.Log(1-u) / math.Log(1-p)))
+	if length < 1 {
+		length = 1
+	}
+	return length
+}
+
+// stratifiedGroups holds, for each distinct stratum (sorted so iteration
+// order is deterministic), the population indices belonging to it.
+type stratifiedGroups struct {
+	indices [][]int
+}
+
+// groupByStratum partitions population indices by their stratum label.
+func groupByStratum(strata []int) stratifiedGroups {
+	byStratum := make(map[int][]int)
+	for i, s := range strata {
+		byStratum[s] = append(byStratum[s], i)
+	}
+	keys := make([]int, 0, len(byStratum))
+	for k := range byStratum {
+		keys = append(keys, k)
+	}
+	sort.Ints(keys)
+
+	indices := make([][]int, len(keys))
+	for i, k := range keys {
+		indices[i] = byStratum[k]
+	}
+	return stratifiedGroups{indices: indices}
+}
+
+// fillStratified resamples with replacement within each stratum,
+// preserving stratum sizes. len(scratch) must equal the total number of
+// indices across groups (i.e. len(Options.Strata)).
+func fillStratified(scratch, population []float64, rng *rand.Rand, groups stratifiedGroups) {
+	pos := 0
+	for _, group := range groups.indices {
+		for range group {
+			scratch[pos] = population[group[rng.IntN(len(group))]]
+			pos++
+		}
+	}
+}
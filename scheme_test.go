@@ -0,0 +1,131 @@
+package main
+
+import (
+	"math"
+	"testing"
+)
+
+// generateAR1 simulates a stationary AR(1) series x_t = phi*x_{t-1} + e_t.
+func generateAR1(n int, phi, sigma float64, seed [32]byte) []float64 {
+	rng := newSeededRand(seed)
+	data := make([]float64, n)
+	data[0] = rng.NormFloat64() * sigma / math.Sqrt(1-phi*phi)
+	for i := 1; i < n; i++ {
+		data[i] = phi*data[i-1] + rng.NormFloat64()*sigma
+	}
+	return data
+}
+
+func TestFillMovingBlockReusesContiguousRuns(t *testing.T) {
+	population := []float64{0, 1, 2, 3, 4, 5, 6, 7, 8, 9}
+	scratch := make([]float64, 6)
+	rng := newSeededRand([32]byte{1})
+
+	fillMovingBlock(scratch, population, rng, 3)
+
+	for b := 0; b < len(scratch); b += 3 {
+		for j := 1; j < 3 && b+j < len(scratch); j++ {
+			if scratch[b+j]-scratch[b+j-1] != 1 {
+				t.Fatalf("expected contiguous block at offset %d, got %v", b, scratch[b:b+3])
+			}
+		}
+	}
+}
+
+func TestFillStratifiedPreservesStratumSizes(t *testing.T) {
+	population := []float64{1, 2, 3, 4, 5, 6}
+	strata := []int{0, 0, 1, 1, 1, 2}
+	groups := groupByStratum(strata)
+
+	scratch := make([]float64, len(population))
+	rng := newSeededRand([32]byte{5})
+	fillStratified(scratch, population, rng, groups)
+
+	inStratum := func(v float64, lo, hi float64) bool { return v >= lo && v <= hi }
+	for _, v := range scratch[:2] {
+		if !inStratum(v, 1, 2) {
+			t.Errorf("expected value from stratum 0 (1 or 2), got %f", v)
+		}
+	}
+	for _, v := range scratch[2:5] {
+		if !inStratum(v, 3, 5) {
+			t.Errorf("expected value from stratum 1 (3-5), got %f", v)
+		}
+	}
+	if scratch[5] != 6 {
+		t.Errorf("expected value from stratum 2 (6), got %f", scratch[5])
+	}
+}
+
+func TestValidateSchemePanicsOnStrataLengthMismatch(t *testing.T) {
+	population := generatePopulation(10, 100.0, 10.0)
+	defer func() {
+		if recover() == nil {
+			t.Fatal("expected a panic when len(Strata) != len(population)")
+		}
+	}()
+	validateScheme(population, 10, Options{Scheme: Stratified, Strata: []int{0, 0, 1}})
+}
+
+func TestValidateSchemePanicsOnSampleSizeMismatch(t *testing.T) {
+	population := generatePopulation(10, 100.0, 10.0)
+	strata := make([]int, len(population))
+	defer func() {
+		if recover() == nil {
+			t.Fatal("expected a panic when sampleSize != len(Strata)")
+		}
+	}()
+	validateScheme(population, 5, Options{Scheme: Stratified, Strata: strata})
+}
+
+func TestValidateSchemeAllowsMatchingStratified(t *testing.T) {
+	population := generatePopulation(10, 100.0, 10.0)
+	strata := make([]int, len(population))
+	validateScheme(population, len(population), Options{Scheme: Stratified, Strata: strata})
+}
+
+func TestBootstrapStratifiedRejectsMismatchedSampleSize(t *testing.T) {
+	population := generatePopulation(10, 100.0, 10.0)
+	strata := make([]int, len(population))
+	defer func() {
+		if recover() == nil {
+			t.Fatal("expected bootstrap to panic on a mismatched sampleSize under Stratified")
+		}
+	}()
+	bootstrap(population, 5, 20, Options{Scheme: Stratified, Strata: strata})
+}
+
+func TestBlockBootstrapCapturesAR1Dependence(t *testing.T) {
+	data := generateAR1(2000, 0.8, 1.0, [32]byte{11})
+
+	iidMeans, _ := bootstrap(data, 300, len(data), Options{Seed: [32]byte{21}})
+	blockMeans, _ := bootstrap(data, 300, len(data), Options{
+		Seed:        [32]byte{22},
+		Scheme:      MovingBlock,
+		BlockLength: AutoBlockLength(data),
+	})
+
+	iidSE := standardError(iidMeans)
+	blockSE := standardError(blockMeans)
+
+	if blockSE <= iidSE*1.3 {
+		t.Errorf("expected block bootstrap SE (%f) to notably exceed naive i.i.d. SE (%f) for AR(1) data", blockSE, iidSE)
+	}
+}
+
+func TestStationaryBootstrapRuns(t *testing.T) {
+	data := generateAR1(500, 0.6, 1.0, [32]byte{12})
+	means, medians := bootstrap(data, 100, len(data), Options{Seed: [32]byte{23}, Scheme: Stationary, BlockLength: 10})
+	if len(means) != 100 || len(medians) != 100 {
+		t.Fatalf("expected 100 replicates, got %d means and %d medians", len(means), len(medians))
+	}
+}
+
+func TestGeometricLengthAtLeastOne(t *testing.T) {
+	rng := newSeededRand([32]byte{9})
+	for i := 0; i < 1000; i++ {
+		if l := geometricLength(rng, 0.5); l < 1 {
+			t.Fatalf("geometricLength returned %d, want >= 1", l)
+		}
+	}
+}
@@ -0,0 +1,79 @@
+package main
+
+import (
+	"math"
+	"sort"
+)
+
+// median returns the median of data without modifying the caller's slice.
+func median(data []float64) float64 {
+	sorted := append([]float64(nil), data...)
+	sort.Float64s(sorted)
+	return medianOfSorted(sorted)
+}
+
+// medianOfSorted returns the median of a slice that is already sorted
+// ascending. It is the allocation-free counterpart to median, used by
+// callers (such as the bootstrap workers) that own a scratch buffer they
+// can sort in place.
+func medianOfSorted(sorted []float64) float64 {
+	n := len(sorted)
+	if n%2 == 0 {
+		return (sorted[n/2-1] + sorted[n/2]) / 2.0
+	}
+	return sorted[n/2]
+}
+
+// Calculate the mean
+func mean(data []float64) float64 {
+	sum := 0.0
+	for _, value := range data {
+		sum += value
+	}
+	return sum / float64(len(data))
+}
+
+// stddev is the sample standard deviation of data.
+func stddev(data []float64) float64 {
+	m := mean(data)
+	variance := 0.0
+	for _, value := range data {
+		variance += math.Pow(value-m, 2)
+	}
+	return math.Sqrt(variance / float64(len(data)-1))
+}
+
+// Calculate the standard error
+func standardError(data []float64) float64 {
+	return stddev(data) / math.Sqrt(float64(len(data)))
+}
+
+// Mean is the arithmetic mean, exposed as a pluggable BootstrapCI stat.
+func Mean(data []float64) float64 { return mean(data) }
+
+// Median is the pluggable median stat for BootstrapCI.
+func Median(data []float64) float64 { return median(data) }
+
+// percentile returns the p-th percentile (0 <= p <= 100) of sorted, which
+// must already be sorted ascending, using linear interpolation between
+// the two closest ranks.
+func percentile(sorted []float64, p float64) float64 {
+	n := len(sorted)
+	if n == 0 {
+		return math.NaN()
+	}
+	if p <= 0 {
+		return sorted[0]
+	}
+	if p >= 100 {
+		return sorted[n-1]
+	}
+	rank := p / 100 * float64(n-1)
+	lo := int(math.Floor(rank))
+	hi := int(math.Ceil(rank))
+	if lo == hi {
+		return sorted[lo]
+	}
+	frac := rank - float64(lo)
+	return sorted[lo] + frac*(sorted[hi]-sorted[lo])
+}
@@ -0,0 +1,44 @@
+package main
+
+import (
+	"math"
+	"testing"
+)
+
+func TestMedian(t *testing.T) {
+	data := []float64{1, 2, 3, 4, 5}
+	expected := 3.0
+	result := median(data)
+	if result != expected {
+		t.Errorf("Expected median %f, got %f", expected, result)
+	}
+}
+
+func TestMedianDoesNotMutateInput(t *testing.T) {
+	data := []float64{5, 3, 1, 4, 2}
+	want := append([]float64(nil), data...)
+	median(data)
+	for i := range data {
+		if data[i] != want[i] {
+			t.Errorf("median mutated its input: got %v, want %v", data, want)
+		}
+	}
+}
+
+func TestMean(t *testing.T) {
+	data := []float64{1, 2, 3, 4, 5}
+	expected := 3.0
+	result := mean(data)
+	if result != expected {
+		t.Errorf("Expected mean %f, got %f", expected, result)
+	}
+}
+
+func TestStandardError(t *testing.T) {
+	data := []float64{1, 2, 3, 4, 5}
+	expected := 0.70710678119
+	result := standardError(data)
+	if math.Abs(result-expected) > 1e-6 {
+		t.Errorf("Expected standard error %f, got %f", expected, result)
+	}
+}